@@ -0,0 +1,243 @@
+package experiments
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// NamedExperiment pairs an Experiment with the label it should appear under in a Comparison report.
+type NamedExperiment struct {
+	Name       string
+	Experiment *Experiment
+}
+
+// PairwiseResult holds the outcome of comparing two named experiments' best-fitness distributions.
+type PairwiseResult struct {
+	A, B string
+
+	// Mann-Whitney U test (two-sided, normal approximation, averaged ranks for ties)
+	U                float64
+	Z                float64
+	PValue           float64
+	PValueBonferroni float64
+
+	// Wilcoxon signed-rank test, only populated when A and B ran the same number of trials
+	Wilcoxon *WilcoxonResult
+}
+
+// WilcoxonResult holds the outcome of a Wilcoxon signed-rank test between two equal-length samples.
+type WilcoxonResult struct {
+	W      float64
+	Z      float64
+	PValue float64
+}
+
+// ComparisonReport summarizes a Comparison's per-experiment distributions and pairwise significance tests.
+type ComparisonReport struct {
+	Names       []string
+	BestFitness map[string]MetricStats
+	Epochs      map[string]MetricStats
+	Pairwise    []PairwiseResult
+}
+
+// Comparison runs cross-algorithm statistical comparisons across any number of named experiments, e.g. plain NEAT
+// vs. a novelty-search variant vs. a DE baseline.
+type Comparison struct {
+	experiments []NamedExperiment
+}
+
+// NewComparison builds a Comparison over the given named experiments.
+func NewComparison(named ...NamedExperiment) *Comparison {
+	return &Comparison{experiments: named}
+}
+
+// Compare computes per-experiment best-fitness/epochs distributions and, for every pair of experiments, a
+// Mann-Whitney U test (with Bonferroni-corrected p-values across all pairs) plus a Wilcoxon signed-rank test
+// wherever the two experiments ran the same number of trials.
+func (c *Comparison) Compare() *ComparisonReport {
+	report := &ComparisonReport{
+		BestFitness: make(map[string]MetricStats, len(c.experiments)),
+		Epochs:      make(map[string]MetricStats, len(c.experiments)),
+	}
+	for _, ne := range c.experiments {
+		report.Names = append(report.Names, ne.Name)
+		report.BestFitness[ne.Name] = computeMetricStats(ne.Experiment.bestFitnessValues())
+		report.Epochs[ne.Name] = computeMetricStats(ne.Experiment.epochValues())
+	}
+
+	numPairs := len(c.experiments) * (len(c.experiments) - 1) / 2
+	for i := 0; i < len(c.experiments); i++ {
+		for j := i + 1; j < len(c.experiments); j++ {
+			a, b := c.experiments[i], c.experiments[j]
+			x, y := a.Experiment.bestFitnessValues(), b.Experiment.bestFitnessValues()
+
+			u, z, p := mannWhitneyU(x, y)
+			result := PairwiseResult{
+				A: a.Name, B: b.Name,
+				U: u, Z: z, PValue: p,
+				PValueBonferroni: bonferroni(p, numPairs),
+			}
+			if len(x) == len(y) {
+				w, wz, wp := wilcoxonSignedRank(x, y)
+				result.Wilcoxon = &WilcoxonResult{W: w, Z: wz, PValue: wp}
+			}
+			report.Pairwise = append(report.Pairwise, result)
+		}
+	}
+	return report
+}
+
+// Report computes this Comparison's statistics and significance tests via Compare, then renders them as a Markdown
+// document to w: a table of each experiment's best-fitness distribution (median, IQR, min/max), followed by a table
+// of pairwise significance tests (Mann-Whitney U, raw and Bonferroni-corrected p-values, and the Wilcoxon
+// signed-rank p-value where applicable).
+func (c *Comparison) Report(w io.Writer) error {
+	report := c.Compare()
+
+	var err error
+	write := func(format string, args ...interface{}) {
+		if err != nil {
+			return
+		}
+		_, err = fmt.Fprintf(w, format, args...)
+	}
+
+	write("## Best fitness by experiment\n\n")
+	write("| Experiment | Median | IQR | Min | Max |\n")
+	write("|---|---|---|---|---|\n")
+	for _, name := range report.Names {
+		s := report.BestFitness[name]
+		write("| %s | %.3f | %.3f | %.3f | %.3f |\n", name, s.Median, s.P75-s.P25, s.Min, s.Max)
+	}
+
+	write("\n## Pairwise significance (best fitness)\n\n")
+	write("| A | B | U | p-value | p (Bonferroni) | Wilcoxon p-value |\n")
+	write("|---|---|---|---|---|---|\n")
+	for _, pw := range report.Pairwise {
+		wilcoxon := "-"
+		if pw.Wilcoxon != nil {
+			wilcoxon = fmt.Sprintf("%.4f", pw.Wilcoxon.PValue)
+		}
+		write("| %s | %s | %.2f | %.4f | %.4f | %s |\n", pw.A, pw.B, pw.U, pw.PValue, pw.PValueBonferroni, wilcoxon)
+	}
+
+	return err
+}
+
+// mannWhitneyU computes the Mann-Whitney U statistic for samples x and y (U = R1 - n1(n1+1)/2, ranks averaged over
+// ties) along with its normal-approximation z-score and two-sided p-value.
+func mannWhitneyU(x, y []float64) (u, z, p float64) {
+	n1, n2 := float64(len(x)), float64(len(y))
+	ranks, tieCorrection := rankWithTies(append(append([]float64{}, x...), y...))
+
+	r1 := 0.0
+	for i := 0; i < len(x); i++ {
+		r1 += ranks[i]
+	}
+
+	u = r1 - n1*(n1+1)/2
+	meanU := n1 * n2 / 2
+	n := n1 + n2
+	stdU := math.Sqrt(n1 * n2 / 12 * ((n + 1) - tieCorrection/(n*(n-1))))
+	if stdU == 0 {
+		return u, 0, 1
+	}
+	z = (u - meanU) / stdU
+	p = 2 * (1 - normalCDF(math.Abs(z)))
+	if p > 1 {
+		p = 1
+	}
+	return u, z, p
+}
+
+// wilcoxonSignedRank computes the Wilcoxon signed-rank statistic for two paired, equal-length samples along with
+// its normal-approximation z-score and two-sided p-value. Zero differences are dropped before ranking.
+func wilcoxonSignedRank(x, y []float64) (w, z, p float64) {
+	diffs := make([]float64, 0, len(x))
+	for i := range x {
+		d := x[i] - y[i]
+		if d != 0 {
+			diffs = append(diffs, d)
+		}
+	}
+	if len(diffs) == 0 {
+		return 0, 0, 1
+	}
+
+	abs := make([]float64, len(diffs))
+	for i, d := range diffs {
+		abs[i] = math.Abs(d)
+	}
+	ranks, tieCorrection := rankWithTies(abs)
+
+	wPlus, wMinus := 0.0, 0.0
+	for i, d := range diffs {
+		if d > 0 {
+			wPlus += ranks[i]
+		} else {
+			wMinus += ranks[i]
+		}
+	}
+	w = math.Min(wPlus, wMinus)
+
+	n := float64(len(diffs))
+	meanW := n * (n + 1) / 4
+	stdW := math.Sqrt(n*(n+1)*(2*n+1)/24 - tieCorrection/48)
+	if stdW <= 0 {
+		return w, 0, 1
+	}
+	z = (w - meanW) / stdW
+	p = 2 * (1 - normalCDF(math.Abs(z)))
+	if p > 1 {
+		p = 1
+	}
+	return w, z, p
+}
+
+// rankWithTies assigns averaged ranks (1-based) to values, returning the ranks in the input order along with the
+// tie correction term sum(t^3 - t) over all groups of tied values, used by both the Mann-Whitney and Wilcoxon
+// normal approximations.
+func rankWithTies(values []float64) (ranks []float64, tieCorrection float64) {
+	type indexed struct {
+		v   float64
+		idx int
+	}
+	sorted := make([]indexed, len(values))
+	for i, v := range values {
+		sorted[i] = indexed{v, i}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].v < sorted[j].v })
+
+	ranks = make([]float64, len(values))
+	i := 0
+	for i < len(sorted) {
+		j := i
+		for j < len(sorted) && sorted[j].v == sorted[i].v {
+			j++
+		}
+		avgRank := float64(i+j+1) / 2 // 1-based average rank over [i, j)
+		t := float64(j - i)
+		tieCorrection += t*t*t - t
+		for k := i; k < j; k++ {
+			ranks[sorted[k].idx] = avgRank
+		}
+		i = j
+	}
+	return ranks, tieCorrection
+}
+
+// bonferroni applies the Bonferroni correction for numTests independent comparisons, capping the result at 1.
+func bonferroni(p float64, numTests int) float64 {
+	corrected := p * float64(numTests)
+	if corrected > 1 {
+		return 1
+	}
+	return corrected
+}
+
+// normalCDF returns the standard normal cumulative distribution function at x.
+func normalCDF(x float64) float64 {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
@@ -0,0 +1,88 @@
+package experiments
+
+import "testing"
+
+func TestRankWithTiesAveragesTiedRanks(t *testing.T) {
+	ranks, tieCorrection := rankWithTies([]float64{1, 2, 2, 3})
+	want := []float64{1, 2.5, 2.5, 4}
+	for i := range want {
+		if ranks[i] != want[i] {
+			t.Errorf("rank[%d] = %v, want %v", i, ranks[i], want[i])
+		}
+	}
+	// one group of 2 tied values: t^3 - t = 2^3 - 2 = 6
+	if tieCorrection != 6 {
+		t.Errorf("tieCorrection = %v, want 6", tieCorrection)
+	}
+}
+
+func TestRankWithTiesNoTies(t *testing.T) {
+	ranks, tieCorrection := rankWithTies([]float64{3, 1, 2})
+	want := []float64{3, 1, 2}
+	for i := range want {
+		if ranks[i] != want[i] {
+			t.Errorf("rank[%d] = %v, want %v", i, ranks[i], want[i])
+		}
+	}
+	if tieCorrection != 0 {
+		t.Errorf("tieCorrection = %v, want 0", tieCorrection)
+	}
+}
+
+func TestMannWhitneyUIdenticalSamplesYieldsHighPValue(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{1, 2, 3, 4, 5}
+	_, _, p := mannWhitneyU(x, y)
+	if p < 0.9 {
+		t.Errorf("expected a high p-value for identical distributions, got %v", p)
+	}
+}
+
+func TestMannWhitneyUSeparatedSamplesYieldsLowPValue(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{101, 102, 103, 104, 105}
+	_, _, p := mannWhitneyU(x, y)
+	if p > 0.05 {
+		t.Errorf("expected a low p-value for clearly separated distributions, got %v", p)
+	}
+}
+
+func TestWilcoxonSignedRankAllZeroDiffsYieldsPOne(t *testing.T) {
+	x := []float64{1, 2, 3}
+	y := []float64{1, 2, 3}
+	_, _, p := wilcoxonSignedRank(x, y)
+	if p != 1 {
+		t.Errorf("expected p=1 when all differences are zero, got %v", p)
+	}
+}
+
+func TestWilcoxonSignedRankWithTiedAbsoluteDifferences(t *testing.T) {
+	// differences are -2, 2, 3: the first two tie in absolute value and must share an averaged rank
+	x := []float64{1, 4, 7}
+	y := []float64{3, 2, 4}
+	w, _, p := wilcoxonSignedRank(x, y)
+	if w < 0 {
+		t.Errorf("W should never be negative, got %v", w)
+	}
+	if p < 0 || p > 1 {
+		t.Errorf("p-value out of range: %v", p)
+	}
+}
+
+func TestBonferroniCapsAtOne(t *testing.T) {
+	if got := bonferroni(0.5, 10); got != 1 {
+		t.Errorf("bonferroni(0.5, 10) = %v, want 1", got)
+	}
+	if got := bonferroni(0.01, 2); got != 0.02 {
+		t.Errorf("bonferroni(0.01, 2) = %v, want 0.02", got)
+	}
+}
+
+func TestNormalCDF(t *testing.T) {
+	if got := normalCDF(0); got != 0.5 {
+		t.Errorf("normalCDF(0) = %v, want 0.5", got)
+	}
+	if got := normalCDF(10); got < 0.999 {
+		t.Errorf("normalCDF(10) = %v, want ~1", got)
+	}
+}
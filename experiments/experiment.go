@@ -15,6 +15,14 @@ type Experiment struct {
 	Id   int
 	Name string
 	Trials
+
+	// Observers are notified as trials in this experiment progress, see RegisterObserver
+	Observers []Observer
+}
+
+// RegisterObserver adds an Observer to be notified of this experiment's progress
+func (e *Experiment) RegisterObserver(obs Observer) {
+	e.Observers = append(e.Observers, obs)
 }
 
 // Calculates average duration of experiment's trial
@@ -92,8 +100,8 @@ func (e *Experiment) Solved() bool {
 }
 
 // The fitness values of the best organisms for each trial
-func (e *Experiment) BestFitness() Floats {
-	var x Floats = make([]float64, len(e.Trials))
+func (e *Experiment) bestFitnessValues() []float64 {
+	x := make([]float64, len(e.Trials))
 	for i, t := range e.Trials {
 		if org, ok := t.BestOrganism(false); ok {
 			x[i] = org.Fitness
@@ -103,8 +111,8 @@ func (e *Experiment) BestFitness() Floats {
 }
 
 // The age values of the organisms for each trial
-func (e *Experiment) BestAge() Floats {
-	var x Floats = make([]float64, len(e.Trials))
+func (e *Experiment) bestAgeValues() []float64 {
+	x := make([]float64, len(e.Trials))
 	for i, t := range e.Trials {
 		if org, ok := t.BestOrganism(false); ok {
 			x[i] = float64(org.Species.Age)
@@ -114,8 +122,8 @@ func (e *Experiment) BestAge() Floats {
 }
 
 // The complexity values of the best organisms for each trial
-func (e *Experiment) BestComplexity() Floats {
-	var x Floats = make([]float64, len(e.Trials))
+func (e *Experiment) bestComplexityValues() []float64 {
+	x := make([]float64, len(e.Trials))
 	for i, t := range e.Trials {
 		if org, ok := t.BestOrganism(false); ok {
 			x[i] = float64(org.Phenotype.Complexity())
@@ -124,18 +132,18 @@ func (e *Experiment) BestComplexity() Floats {
 	return x
 }
 
-// Diversity returns the average number of species in each trial
-func (e *Experiment) Diversity() Floats {
-	var x Floats = make([]float64, len(e.Trials))
+// The average number of species in each trial
+func (e *Experiment) diversityValues() []float64 {
+	x := make([]float64, len(e.Trials))
 	for i, t := range e.Trials {
 		x[i] = t.Diversity().Mean()
 	}
 	return x
 }
 
-// Trials returns the number of epochs in each trial
-func (e *Experiment) Epochs() Floats {
-	var x Floats = make([]float64, len(e.Trials))
+// The number of epochs evaluated in each trial
+func (e *Experiment) epochValues() []float64 {
+	x := make([]float64, len(e.Trials))
 	for i, t := range e.Trials {
 		x[i] = float64(len(t.Generations))
 	}
@@ -193,58 +201,18 @@ func (ex *Experiment) PrintStatistics() {
 		fmt.Println("\nNo winner found in the experiment!!!")
 	}
 
-	// Print average winner statistics
-	mean_complexity, mean_diversity, mean_age, mean_fitness := 0.0, 0.0, 0.0, 0.0
+	// Print average winner statistics, reusing AvgWinner rather than re-deriving it here
 	if len(ex.Trials) > 1 {
-		avg_nodes, avg_genes, avg_evals, avg_divers := 0.0, 0.0, 0.0, 0.0
-		count := 0.0
-		for i := 0; i < len(ex.Trials); i++ {
-			t := ex.Trials[i]
-			if t.Solved() {
-				nodes, genes, evals, diversity := t.Winner()
-				avg_nodes += float64(nodes)
-				avg_genes += float64(genes)
-				avg_evals += float64(evals)
-				avg_divers += float64(diversity)
-
-				mean_complexity += float64(t.WinnerGeneration.Best.Phenotype.Complexity())
-				mean_age += float64(t.WinnerGeneration.Best.Species.Age)
-				mean_fitness += t.WinnerGeneration.Best.Fitness
-
-				count++
-			}
-		}
-		avg_nodes /= count
-		avg_genes /= count
-		avg_evals /= count
-		avg_divers /= count
+		avg_nodes, avg_genes, avg_evals, avg_divers := ex.AvgWinner()
 		fmt.Printf("\nAverage among winners\n\tWinner Nodes:\t%.1f\n\tWinner Genes:\t%.1f\n\tWinner Evals:\t%.1f\n\n\tDiversity:\t%.1f\n",
 			avg_nodes, avg_genes, avg_evals, avg_divers)
-
-		mean_complexity /= count
-		mean_age /= count
-		mean_fitness /=count
-		fmt.Printf("\tComplexity:\t%.1f\n\tAge:\t\t%.1f\n\tFitness:\t%.1f\n",
-			mean_complexity, mean_age, mean_fitness)
 	}
 
-	// Print the average values for each population of organisms evaluated
-	count := float64(len(ex.Trials))
-	for _, t := range ex.Trials {
-		fitness, age, complexity := t.Average()
-
-		mean_complexity += complexity.Mean()
-		mean_diversity += t.Diversity().Mean()
-		mean_age += age.Mean()
-		mean_fitness += fitness.Mean()
-	}
-	mean_complexity /= count
-	mean_diversity /= count
-	mean_age /= count
-	mean_fitness /=count
+	// Print summary statistics via Statistics(): the mean over trials of each trial's species diversity and of its
+	// best organism's complexity, age and fitness
+	stats := ex.Statistics()
 	fmt.Printf("\nAverages for all organisms evaluated during experiment\n\tDiversity:\t%.1f\n\tComplexity:\t%.1f\n\tAge:\t\t%.1f\n\tFitness:\t%.1f\n\n",
-		mean_diversity, mean_complexity, mean_age, mean_fitness)
-
+		stats.Diversity.Mean, stats.BestComplexity.Mean, stats.BestAge.Mean, stats.BestFitness.Mean)
 }
 
 // Encodes experiment and writes to provided writer
@@ -0,0 +1,143 @@
+package experiments
+
+import (
+	"encoding/gob"
+	"math"
+	"sort"
+)
+
+// BehaviorDescriptor is a point in an organism's behavior space, reported via genetics.BehaviorProvider and
+// compared against the archive and current population to compute novelty.
+type BehaviorDescriptor []float64
+
+// distance returns the Euclidean distance between two behavior descriptors.
+func (b BehaviorDescriptor) distance(other BehaviorDescriptor) float64 {
+	sum := 0.0
+	for i := range b {
+		d := b[i] - other[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// defaultMaxArchiveSize bounds the archive when NewNoveltySearch is called without an explicit cap, so that a long
+// run can't grow the archive (and the cost of scoring novelty against it) without bound.
+const defaultMaxArchiveSize = 10000
+
+// NoveltySearch maintains a persistent archive of behaviorally novel organisms, alongside fitness-driven evolution.
+// Each organism's novelty is the mean distance to its k nearest neighbors across the archive plus the current
+// population; organisms whose novelty clears ArchiveThreshold are added to the archive so future generations keep
+// being compared against them. Rho blends novelty with raw fitness via EffectiveFitness.
+type NoveltySearch struct {
+	// K is the number of nearest neighbors averaged over when scoring novelty
+	K int
+	// ArchiveThreshold is the minimum novelty score required for a behavior to be archived
+	ArchiveThreshold float64
+	// Rho blends fitness and novelty in EffectiveFitness: effective = (1-Rho)*fitness + Rho*novelty
+	Rho float64
+	// MaxArchiveSize caps the number of behaviors retained in the archive; once the cap is reached, archiving a new
+	// behavior evicts the oldest one first (FIFO)
+	MaxArchiveSize int
+
+	archive []BehaviorDescriptor
+}
+
+// NewNoveltySearch creates a NoveltySearch with an empty archive, capped at defaultMaxArchiveSize behaviors.
+func NewNoveltySearch(k int, archiveThreshold, rho float64) *NoveltySearch {
+	return &NoveltySearch{K: k, ArchiveThreshold: archiveThreshold, Rho: rho, MaxArchiveSize: defaultMaxArchiveSize}
+}
+
+// ArchiveSize returns the number of behaviors currently held in the archive.
+func (ns *NoveltySearch) ArchiveSize() int {
+	return len(ns.archive)
+}
+
+// Evaluate scores descriptor's novelty as the mean distance to its K nearest neighbors among the archive and the
+// rest of the current population, then archives it if that novelty clears ArchiveThreshold. population should hold
+// the rest of the current generation's behaviors, excluding descriptor itself.
+//
+// The parameters are plain []float64/[][]float64, rather than BehaviorDescriptor/[]BehaviorDescriptor, so that
+// NoveltySearch satisfies genetics.NoveltyEvaluator and can be passed directly to genetics.ApplyNoveltyScores
+// without genetics importing this package.
+func (ns *NoveltySearch) Evaluate(descriptor []float64, population [][]float64) float64 {
+	d := BehaviorDescriptor(descriptor)
+
+	neighborhood := make([]BehaviorDescriptor, 0, len(ns.archive)+len(population))
+	neighborhood = append(neighborhood, ns.archive...)
+	for _, p := range population {
+		neighborhood = append(neighborhood, BehaviorDescriptor(p))
+	}
+
+	distances := make([]float64, 0, len(neighborhood))
+	for _, candidate := range neighborhood {
+		distances = append(distances, d.distance(candidate))
+	}
+	sort.Float64s(distances)
+
+	k := ns.K
+	if k > len(distances) {
+		k = len(distances)
+	}
+	novelty := 0.0
+	for i := 0; i < k; i++ {
+		novelty += distances[i]
+	}
+	if k > 0 {
+		novelty /= float64(k)
+	}
+
+	if novelty >= ns.ArchiveThreshold {
+		ns.archiveBehavior(d)
+	}
+	return novelty
+}
+
+// archiveBehavior appends descriptor to the archive, evicting the oldest entry first (FIFO) if MaxArchiveSize is
+// set and already reached.
+func (ns *NoveltySearch) archiveBehavior(descriptor BehaviorDescriptor) {
+	if ns.MaxArchiveSize > 0 && len(ns.archive) >= ns.MaxArchiveSize {
+		ns.archive = ns.archive[1:]
+	}
+	ns.archive = append(ns.archive, descriptor)
+}
+
+// EffectiveFitness blends raw fitness with a novelty score according to Rho.
+func (ns *NoveltySearch) EffectiveFitness(fitness, novelty float64) float64 {
+	return (1-ns.Rho)*fitness + ns.Rho*novelty
+}
+
+// Encode writes this NoveltySearch's archive with GOB encoding (see genetics.BehaviorProvider and
+// ApplyNoveltyScores for how the archive is populated during a run).
+//
+// Trial's own Encode/Decode do not call Encode/Decode here, so the archive is not part of a Trial's or an
+// Experiment's gob stream; it is written only where a caller invokes Encode itself.
+func (ns *NoveltySearch) Encode(enc *gob.Encoder) error {
+	if err := enc.Encode(len(ns.archive)); err != nil {
+		return err
+	}
+	for _, descriptor := range ns.archive {
+		if err := enc.Encode([]float64(descriptor)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads a NoveltySearch's archive back from GOB encoding, replacing whatever archive this NoveltySearch
+// currently holds.
+func (ns *NoveltySearch) Decode(dec *gob.Decoder) error {
+	var n int
+	if err := dec.Decode(&n); err != nil {
+		return err
+	}
+	archive := make([]BehaviorDescriptor, n)
+	for i := range archive {
+		var values []float64
+		if err := dec.Decode(&values); err != nil {
+			return err
+		}
+		archive[i] = BehaviorDescriptor(values)
+	}
+	ns.archive = archive
+	return nil
+}
@@ -0,0 +1,91 @@
+package experiments
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestBehaviorDescriptorDistance(t *testing.T) {
+	a := BehaviorDescriptor{0, 0}
+	b := BehaviorDescriptor{3, 4}
+	if got, want := a.distance(b), 5.0; got != want {
+		t.Errorf("distance = %v, want %v", got, want)
+	}
+}
+
+func TestNoveltySearchEvaluateAveragesKNearestNeighbors(t *testing.T) {
+	ns := NewNoveltySearch(2, 1000, 0.5) // threshold high enough that nothing gets archived
+	population := [][]float64{{0, 1}, {0, 2}, {0, 10}}
+	novelty := ns.Evaluate([]float64{0, 0}, population)
+
+	// nearest two neighbors are at distance 1 and 2, so mean novelty should be 1.5
+	if novelty != 1.5 {
+		t.Errorf("novelty = %v, want 1.5", novelty)
+	}
+	if ns.ArchiveSize() != 0 {
+		t.Errorf("expected nothing archived below threshold, archive size = %d", ns.ArchiveSize())
+	}
+}
+
+func TestNoveltySearchArchivesAboveThreshold(t *testing.T) {
+	ns := NewNoveltySearch(1, 0.5, 0.5)
+	ns.Evaluate([]float64{0, 0}, [][]float64{{10, 0}})
+	if ns.ArchiveSize() != 1 {
+		t.Fatalf("expected the novel behavior to be archived, archive size = %d", ns.ArchiveSize())
+	}
+}
+
+func TestNoveltySearchArchiveFIFOEviction(t *testing.T) {
+	ns := NewNoveltySearch(1, 0, 0.5) // threshold 0 so every descriptor gets archived
+	ns.MaxArchiveSize = 2
+
+	ns.Evaluate([]float64{1}, nil)
+	ns.Evaluate([]float64{2}, nil)
+	if ns.ArchiveSize() != 2 {
+		t.Fatalf("archive size = %d, want 2", ns.ArchiveSize())
+	}
+
+	ns.Evaluate([]float64{3}, nil)
+	if ns.ArchiveSize() != 2 {
+		t.Fatalf("archive size after eviction = %d, want 2 (capped)", ns.ArchiveSize())
+	}
+	if ns.archive[0][0] != 2 || ns.archive[1][0] != 3 {
+		t.Errorf("expected the oldest entry (1) to be evicted first, archive = %v", ns.archive)
+	}
+}
+
+func TestNoveltySearchEffectiveFitnessBlendsFitnessAndNovelty(t *testing.T) {
+	ns := &NoveltySearch{Rho: 0.25}
+	got := ns.EffectiveFitness(4, 8)
+	want := 0.75*4 + 0.25*8
+	if got != want {
+		t.Errorf("EffectiveFitness = %v, want %v", got, want)
+	}
+}
+
+func TestNoveltySearchEncodeDecodeRoundTrip(t *testing.T) {
+	ns := NewNoveltySearch(1, 0, 0.5)
+	ns.Evaluate([]float64{1, 2}, nil)
+	ns.Evaluate([]float64{3, 4}, nil)
+
+	var buf bytes.Buffer
+	if err := ns.Encode(gob.NewEncoder(&buf)); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	decoded := &NoveltySearch{}
+	if err := decoded.Decode(gob.NewDecoder(&buf)); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if decoded.ArchiveSize() != ns.ArchiveSize() {
+		t.Fatalf("decoded archive size = %d, want %d", decoded.ArchiveSize(), ns.ArchiveSize())
+	}
+	for i := range ns.archive {
+		for j := range ns.archive[i] {
+			if decoded.archive[i][j] != ns.archive[i][j] {
+				t.Errorf("archive[%d][%d] = %v, want %v", i, j, decoded.archive[i][j], ns.archive[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package npy
+
+import (
+	"io"
+	"math"
+
+	"github.com/dmarkham/goNEAT/experiments"
+)
+
+// metrics lists the per-generation trajectories exported for every experiment, in the order they are written to
+// the .npz archive.
+var metrics = []string{"best_fitness", "mean_fitness", "complexity", "diversity", "species_count", "age"}
+
+// DumpExperiment writes exp as a .npz bundle of per-generation trajectories to w: one [trials, maxGenerations]
+// float32 array per metric in metrics, NaN-padded for trials that terminated before maxGenerations, plus a 1D
+// winner_generation array holding the index of the winning generation for each trial (-1 if the trial was never
+// solved).
+func DumpExperiment(exp *experiments.Experiment, w io.Writer) error {
+	trials := len(exp.Trials)
+	maxGen := 0
+	for _, t := range exp.Trials {
+		if len(t.Generations) > maxGen {
+			maxGen = len(t.Generations)
+		}
+	}
+
+	tables := make(map[string][]float32, len(metrics))
+	for _, m := range metrics {
+		tables[m] = nanFilled(trials * maxGen)
+	}
+	winnerGen := make([]float32, trials)
+
+	for i, t := range exp.Trials {
+		winnerGen[i] = -1
+		for g, gen := range t.Generations {
+			idx := i*maxGen + g
+			tables["best_fitness"][idx] = float32(gen.Best.Fitness)
+			fitness, age, complexity := gen.Average()
+			tables["mean_fitness"][idx] = float32(fitness.Mean())
+			tables["complexity"][idx] = float32(complexity.Mean())
+			tables["diversity"][idx] = float32(gen.Diversity().Mean())
+			tables["species_count"][idx] = float32(len(gen.Diversity()))
+			tables["age"][idx] = float32(age.Mean())
+		}
+
+		// A trial stops as soon as it solves, so the winning generation is always its last one; this avoids matching
+		// on Best.Fitness, which can tie across generations once a population plateaus.
+		if t.Solved() {
+			winnerGen[i] = float32(len(t.Generations) - 1)
+		}
+	}
+
+	bundle := NewBundle()
+	for _, m := range metrics {
+		bundle.Add(m, []int{trials, maxGen}, tables[m])
+	}
+	bundle.Add("winner_generation", []int{trials}, winnerGen)
+
+	return bundle.WriteNPZ(w)
+}
+
+// nanFilled returns a float32 slice of length n pre-filled with NaN, used to pad trials that terminated before the
+// experiment's longest trial.
+func nanFilled(n int) []float32 {
+	x := make([]float32, n)
+	nan := float32(math.NaN())
+	for i := range x {
+		x[i] = nan
+	}
+	return x
+}
@@ -0,0 +1,122 @@
+// Package npy writes NumPy-compatible .npy/.npz array bundles without depending on any external library.
+package npy
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// magic is the fixed 6 byte prefix of every .npy v1.0 file.
+var magic = []byte("\x93NUMPY")
+
+// WriteArray writes a single 2D or 1D float32 array in .npy v1.0 format to w. data must be laid out in row-major
+// (C) order and have exactly len(shape) == 1 or 2 dimensions whose product equals len(data).
+func WriteArray(w io.Writer, shape []int, data []float32) error {
+	count := 1
+	for _, d := range shape {
+		count *= d
+	}
+	if count != len(data) {
+		return fmt.Errorf("npy: shape %v does not match data length %d", shape, len(data))
+	}
+
+	header := buildHeader(shape)
+	if _, err := w.Write(magic); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{1, 0}); err != nil { // version 1.0
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint16(len(header))); err != nil {
+		return err
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, data)
+}
+
+// buildHeader renders the ASCII Python-dict header describing a '<f4' array of the given shape, padded with spaces
+// and terminated with '\n' so that (magic + version + header-len + header) is a multiple of 64 bytes, as required
+// by the .npy format.
+func buildHeader(shape []int) []byte {
+	shapeStr := "("
+	for i, d := range shape {
+		if i > 0 {
+			shapeStr += ", "
+		}
+		shapeStr += fmt.Sprintf("%d", d)
+	}
+	if len(shape) == 1 {
+		shapeStr += ","
+	}
+	shapeStr += ")"
+
+	dict := fmt.Sprintf("{'descr': '<f4', 'fortran_order': False, 'shape': %s, }", shapeStr)
+
+	// total preamble length (magic + version + header-len field) is 10 bytes; pad so the whole preamble is a
+	// multiple of 64, with the header itself terminated by a newline.
+	const preamble = 10
+	padded := preamble + len(dict) + 1
+	if rem := padded % 64; rem != 0 {
+		dict += spaces(64 - rem)
+	}
+	dict += "\n"
+	return []byte(dict)
+}
+
+func spaces(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = ' '
+	}
+	return string(b)
+}
+
+// Bundle accumulates named arrays to be written out together as a single .npz archive (a plain ZIP of .npy files).
+type Bundle struct {
+	arrays map[string]namedArray
+	order  []string
+}
+
+type namedArray struct {
+	shape []int
+	data  []float32
+}
+
+// NewBundle creates an empty array bundle.
+func NewBundle() *Bundle {
+	return &Bundle{arrays: make(map[string]namedArray)}
+}
+
+// Add registers a named 2D or 1D float32 array to be written into the bundle. Adding the same name twice overwrites
+// the previous array but preserves its original position in the archive.
+func (b *Bundle) Add(name string, shape []int, data []float32) {
+	if _, exists := b.arrays[name]; !exists {
+		b.order = append(b.order, name)
+	}
+	b.arrays[name] = namedArray{shape: shape, data: data}
+}
+
+// WriteNPZ writes every array in this bundle to w as a single .npz (ZIP) archive, one "<name>.npy" entry per array.
+func (b *Bundle) WriteNPZ(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for _, name := range b.order {
+		arr := b.arrays[name]
+		entry, err := zw.Create(name + ".npy")
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := WriteArray(&buf, arr.shape, arr.data); err != nil {
+			return err
+		}
+		if _, err := entry.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,80 @@
+package npy
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestBuildHeaderPadsToMultipleOf64(t *testing.T) {
+	for _, shape := range [][]int{{3}, {2, 5}, {1}, {100, 200}} {
+		header := buildHeader(shape)
+		total := len(magic) + 2 /* version */ + 2 /* header-len field */ + len(header)
+		if total%64 != 0 {
+			t.Errorf("shape %v: preamble+header length %d is not a multiple of 64", shape, total)
+		}
+		if header[len(header)-1] != '\n' {
+			t.Errorf("shape %v: header does not end in a newline", shape)
+		}
+	}
+}
+
+func TestWriteArrayRoundTrip(t *testing.T) {
+	shape := []int{2, 3}
+	data := []float32{1, 2, 3, 4, 5, 6}
+
+	var buf bytes.Buffer
+	if err := WriteArray(&buf, shape, data); err != nil {
+		t.Fatalf("WriteArray failed: %v", err)
+	}
+
+	raw := buf.Bytes()
+	if !bytes.Equal(raw[:6], magic) {
+		t.Fatalf("missing .npy magic prefix")
+	}
+	headerLen := binary.LittleEndian.Uint16(raw[8:10])
+	payload := raw[10+int(headerLen):]
+
+	got := make([]float32, len(data))
+	if err := binary.Read(bytes.NewReader(payload), binary.LittleEndian, &got); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	for i, v := range data {
+		if got[i] != v {
+			t.Errorf("element %d = %v, want %v", i, got[i], v)
+		}
+	}
+}
+
+func TestWriteArrayShapeMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteArray(&buf, []int{2, 2}, []float32{1, 2, 3}); err == nil {
+		t.Fatal("expected an error for mismatched shape/data length")
+	}
+}
+
+func TestBundleWriteNPZ(t *testing.T) {
+	b := NewBundle()
+	b.Add("a", []int{2}, []float32{1, 2})
+	b.Add("b", []int{1}, []float32{3})
+
+	var buf bytes.Buffer
+	if err := b.WriteNPZ(&buf); err != nil {
+		t.Fatalf("WriteNPZ failed: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open npz as zip: %v", err)
+	}
+	names := make(map[string]bool, len(zr.File))
+	for _, f := range zr.File {
+		names[f.Name] = true
+	}
+	for _, want := range []string{"a.npy", "b.npy"} {
+		if !names[want] {
+			t.Errorf("expected npz entry %q, got entries %v", want, names)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package experiments
+
+import (
+	"github.com/dmarkham/goNEAT/neat/genetics"
+)
+
+// Observer is notified of an experiment's progress as it runs, so that progress can be monitored live (e.g. by the
+// experiments/webmon dashboard) without coupling the trial loop to any particular UI.
+type Observer interface {
+	// OnGenerationEnd is called once a generation's epoch has been evaluated within a trial
+	OnGenerationEnd(trialID, generation int, pop *genetics.Population)
+	// OnTrialEnd is called once a trial has finished running all of its generations
+	OnTrialEnd(trialID int, trial Trial)
+	// OnExperimentEnd is called once every trial of the experiment has finished
+	OnExperimentEnd(experiment *Experiment)
+}
+
+// NotifyGenerationEnd fans out a generation-end notification to all registered observers. It is meant to be called
+// by TrialRunner implementations from within their own generation loop, since Experiment itself does not run
+// generations directly.
+func (e *Experiment) NotifyGenerationEnd(trialID, generation int, pop *genetics.Population) {
+	for _, obs := range e.Observers {
+		obs.OnGenerationEnd(trialID, generation, pop)
+	}
+}
+
+// notifyTrialEnd fans out a trial-end notification to all registered observers.
+func (e *Experiment) notifyTrialEnd(trialID int, trial Trial) {
+	for _, obs := range e.Observers {
+		obs.OnTrialEnd(trialID, trial)
+	}
+}
+
+// notifyExperimentEnd fans out an experiment-end notification to all registered observers.
+func (e *Experiment) notifyExperimentEnd() {
+	for _, obs := range e.Observers {
+		obs.OnExperimentEnd(e)
+	}
+}
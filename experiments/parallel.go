@@ -0,0 +1,126 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+)
+
+// TrialRunner executes a single trial to completion and returns the resulting Trial record. Implementations are
+// expected to use the supplied RNG for any random decisions so that trials remain reproducible no matter how they
+// are scheduled across worker goroutines.
+type TrialRunner interface {
+	// Run executes this trial, observing ctx cancellation, and returns the collected Trial results
+	Run(ctx context.Context) (Trial, error)
+}
+
+// MultiError aggregates the per-trial errors collected by RunParallel, rather than reporting only the first one and
+// discarding the rest.
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d trial(s) failed: %s", len(m.Errors), strings.Join(msgs, "; "))
+}
+
+// RunParallel fans the given number of trials out across a pool of workers. The factory builds a TrialRunner for
+// each trial id, seeded deterministically from the top-level experiment seed via a *rand.Rand private to that
+// trial, and workers pull trial ids off a shared queue until it is drained or ctx is cancelled. Results are written
+// back into e.Trials in trial id order, so the outcome of a given trial id is the same regardless of which worker
+// executed it or in what order workers finished. Trial ids that never got a chance to run because ctx was cancelled
+// before they were dispatched are recorded as failed with ctx.Err() rather than being silently included as
+// zero-value trials. Every per-trial error, including these, is collected into a *MultiError and returned to the
+// caller; all other trials are still allowed to finish.
+func (e *Experiment) RunParallel(ctx context.Context, trials int, seed int64,
+	factory func(trialID int, rng *rand.Rand) (TrialRunner, error), workers int) error {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	results := make([]Trial, trials)
+	errs := make([]error, trials)
+	dispatched := make([]bool, trials)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for trialID := range jobs {
+				select {
+				case <-ctx.Done():
+					errs[trialID] = ctx.Err()
+					continue
+				default:
+				}
+
+				runner, err := factory(trialID, trialRand(seed, trialID))
+				if err != nil {
+					errs[trialID] = err
+					continue
+				}
+				trial, err := runner.Run(ctx)
+				if err != nil {
+					errs[trialID] = err
+					continue
+				}
+				results[trialID] = trial
+				e.notifyTrialEnd(trialID, trial)
+			}
+		}()
+	}
+
+dispatch:
+	for trialID := 0; trialID < trials; trialID++ {
+		select {
+		case jobs <- trialID:
+			dispatched[trialID] = true
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for trialID, wasDispatched := range dispatched {
+		if !wasDispatched {
+			errs[trialID] = ctx.Err()
+		}
+	}
+
+	var multi MultiError
+	completed := make([]Trial, 0, trials)
+	for i, err := range errs {
+		if err != nil {
+			multi.Errors = append(multi.Errors, fmt.Errorf("trial %d failed: %w", i, err))
+			continue
+		}
+		completed = append(completed, results[i])
+	}
+
+	e.Trials = completed
+	e.notifyExperimentEnd()
+	if len(multi.Errors) > 0 {
+		return &multi
+	}
+	return nil
+}
+
+// trialRand builds a *rand.Rand deterministically seeded from the top-level experiment seed and trial id, so that
+// re-running the same experiment always feeds the same pseudo random sequence to the same trial id regardless of
+// worker count or scheduling order. A factory that draws only from the returned *rand.Rand, rather than the global
+// math/rand source, keeps its trial reproducible regardless of the order in which goroutines run.
+//
+// neat/genetics accepts no *rand.Rand in its public API: its mutation and crossover operators draw from the global
+// math/rand source, so any part of a trial that runs through them is not reproducible from this seed.
+func trialRand(seed int64, trialID int) *rand.Rand {
+	return rand.New(rand.NewSource(seed + int64(trialID)*2654435761))
+}
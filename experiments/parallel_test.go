@@ -0,0 +1,71 @@
+package experiments
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingTrialRunner blocks until ctx is cancelled, then reports ctx.Err(), simulating a long-running trial that
+// notices cancellation mid-flight.
+type blockingTrialRunner struct{}
+
+func (blockingTrialRunner) Run(ctx context.Context) (Trial, error) {
+	<-ctx.Done()
+	return Trial{}, ctx.Err()
+}
+
+func TestRunParallelCancellationExcludesUndispatchedTrials(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan struct{})
+	factory := func(trialID int, rng *rand.Rand) (TrialRunner, error) {
+		if trialID == 0 {
+			close(started)
+		}
+		return blockingTrialRunner{}, nil
+	}
+
+	e := &Experiment{}
+	done := make(chan error, 1)
+	go func() {
+		done <- e.RunParallel(ctx, 10, 1, factory, 1)
+	}()
+
+	<-started
+	cancel()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunParallel did not return after cancellation")
+	}
+
+	var multi *MultiError
+	if !errors.As(err, &multi) {
+		t.Fatalf("expected *MultiError, got %v (%T)", err, err)
+	}
+	if len(multi.Errors) == 0 {
+		t.Fatal("expected at least one error for the cancelled trials")
+	}
+	if len(e.Trials) != 0 {
+		t.Fatalf("expected no completed trials, got %d fake/zero-value entries", len(e.Trials))
+	}
+}
+
+func TestMultiErrorAggregatesAllFailures(t *testing.T) {
+	multi := &MultiError{Errors: []error{errors.New("boom 1"), errors.New("boom 2")}}
+	msg := multi.Error()
+	if msg == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+	for _, want := range []string{"boom 1", "boom 2", "2 trial"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected error message %q to contain %q", msg, want)
+		}
+	}
+}
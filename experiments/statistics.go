@@ -0,0 +1,143 @@
+package experiments
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// MetricStats is a summary of one metric's distribution of values across all trials of an experiment.
+type MetricStats struct {
+	Min, Max float64
+	Mean     float64
+	Median   float64
+	StdDevP  float64 // population standard deviation
+	P25, P75 float64
+	P95, P99 float64
+}
+
+// ExperimentStats is a unified summary of an Experiment's key metrics, replacing the individual BestFitness,
+// BestAge, BestComplexity, Diversity, and Epochs accessors with a single entry point exposing Min, Max, Mean,
+// Median, StdDevP and percentiles for each one.
+type ExperimentStats struct {
+	BestFitness    MetricStats
+	BestAge        MetricStats
+	BestComplexity MetricStats
+	Diversity      MetricStats
+	Epochs         MetricStats
+}
+
+// Statistics computes summary statistics for this experiment's best fitness, age, complexity, diversity, and
+// epochs-per-trial metrics, so that callers no longer need to reduce the raw per-trial values themselves.
+func (e *Experiment) Statistics() *ExperimentStats {
+	return &ExperimentStats{
+		BestFitness:    computeMetricStats(e.bestFitnessValues()),
+		BestAge:        computeMetricStats(e.bestAgeValues()),
+		BestComplexity: computeMetricStats(e.bestComplexityValues()),
+		Diversity:      computeMetricStats(e.diversityValues()),
+		Epochs:         computeMetricStats(e.epochValues()),
+	}
+}
+
+// computeMetricStats reduces a slice of per-trial values into a MetricStats summary. Percentiles are computed by
+// linear interpolation between the two closest ranks, same as NumPy's default ("linear") interpolation.
+func computeMetricStats(values []float64) MetricStats {
+	if len(values) == 0 {
+		return MetricStats{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(len(sorted))
+
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(sorted))
+
+	return MetricStats{
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		Mean:    mean,
+		Median:  percentile(sorted, 50),
+		StdDevP: math.Sqrt(variance),
+		P25:     percentile(sorted, 25),
+		P75:     percentile(sorted, 75),
+		P95:     percentile(sorted, 95),
+		P99:     percentile(sorted, 99),
+	}
+}
+
+// percentile returns the p-th percentile (0-100) of an already sorted slice using linear interpolation.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := (p / 100) * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo]*(1-frac) + sorted[hi]*frac
+}
+
+// WriteCSV writes this ExperimentStats as a CSV table, one row per metric, so results can be fed into downstream
+// analysis or plotting pipelines without depending on goNEAT's types.
+func (s *ExperimentStats) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"metric", "min", "max", "mean", "median", "stddev_p", "p25", "p75", "p95", "p99"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range s.rows() {
+		record := []string{row.name}
+		for _, v := range row.values() {
+			record = append(record, fmt.Sprintf("%g", v))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes this ExperimentStats as a JSON object keyed by metric name.
+func (s *ExperimentStats) WriteJSON(w io.Writer) error {
+	out := make(map[string]MetricStats, 5)
+	for _, row := range s.rows() {
+		out[row.name] = row.stats
+	}
+	return json.NewEncoder(w).Encode(out)
+}
+
+// metricRow pairs a metric's name with its computed stats, so WriteCSV/WriteJSON can iterate them in a stable order.
+type metricRow struct {
+	name  string
+	stats MetricStats
+}
+
+func (r metricRow) values() []float64 {
+	s := r.stats
+	return []float64{s.Min, s.Max, s.Mean, s.Median, s.StdDevP, s.P25, s.P75, s.P95, s.P99}
+}
+
+func (s *ExperimentStats) rows() []metricRow {
+	return []metricRow{
+		{"best_fitness", s.BestFitness},
+		{"best_age", s.BestAge},
+		{"best_complexity", s.BestComplexity},
+		{"diversity", s.Diversity},
+		{"epochs", s.Epochs},
+	}
+}
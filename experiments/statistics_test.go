@@ -0,0 +1,72 @@
+package experiments
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPercentileLinearInterpolation(t *testing.T) {
+	sorted := []float64{10, 20, 30, 40}
+
+	// rank = (p/100)*(n-1); for p=50, rank=1.5 -> interpolates between sorted[1]=20 and sorted[2]=30
+	if got, want := percentile(sorted, 50), 25.0; got != want {
+		t.Errorf("P50 = %v, want %v", got, want)
+	}
+	if got, want := percentile(sorted, 0), 10.0; got != want {
+		t.Errorf("P0 = %v, want %v", got, want)
+	}
+	if got, want := percentile(sorted, 100), 40.0; got != want {
+		t.Errorf("P100 = %v, want %v", got, want)
+	}
+}
+
+func TestComputeMetricStats(t *testing.T) {
+	stats := computeMetricStats([]float64{1, 2, 3, 4, 5})
+	if stats.Min != 1 || stats.Max != 5 {
+		t.Errorf("Min/Max = %v/%v, want 1/5", stats.Min, stats.Max)
+	}
+	if stats.Mean != 3 {
+		t.Errorf("Mean = %v, want 3", stats.Mean)
+	}
+	if stats.Median != 3 {
+		t.Errorf("Median = %v, want 3", stats.Median)
+	}
+
+	want := 1.4142135623730951 // population stddev of [1,2,3,4,5] is sqrt(2)
+	if diff := stats.StdDevP - want; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("StdDevP = %v, want %v", stats.StdDevP, want)
+	}
+}
+
+func TestComputeMetricStatsEmpty(t *testing.T) {
+	stats := computeMetricStats(nil)
+	if stats != (MetricStats{}) {
+		t.Errorf("expected zero-value MetricStats for empty input, got %+v", stats)
+	}
+}
+
+func TestExperimentStatsWriteCSV(t *testing.T) {
+	stats := &ExperimentStats{BestFitness: computeMetricStats([]float64{1, 2, 3})}
+	var buf strings.Builder
+	if err := stats.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "best_fitness") {
+		t.Errorf("expected CSV output to contain best_fitness row, got %q", out)
+	}
+	if !strings.HasPrefix(out, "metric,min,max,mean,median,stddev_p,p25,p75,p95,p99") {
+		t.Errorf("expected CSV header, got %q", out)
+	}
+}
+
+func TestExperimentStatsWriteJSON(t *testing.T) {
+	stats := &ExperimentStats{Epochs: computeMetricStats([]float64{5, 10})}
+	var buf strings.Builder
+	if err := stats.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"epochs"`) {
+		t.Errorf("expected JSON output to contain epochs key, got %q", buf.String())
+	}
+}
@@ -0,0 +1,172 @@
+// Package webmon implements an experiments.Observer that serves a small live HTTP dashboard of an experiment's
+// progress: a rolling min/mean/max fitness chart per trial, plus the current best network topology rendered as SVG.
+// Mean complexity and species count are recorded in each trial's rolling history but are not drawn.
+package webmon
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/dmarkham/goNEAT/experiments"
+	"github.com/dmarkham/goNEAT/neat/genetics"
+)
+
+// historyLimit bounds how many generations of rolling history the dashboard keeps per trial.
+const historyLimit = 500
+
+// frameQueueSize bounds how many pending generation snapshots the monitor buffers before it starts dropping frames.
+// Keeping this small is the point: a slow browser must never make the experiment wait on the UI.
+const frameQueueSize = 8
+
+// point is one generation's worth of rolling statistics for a single trial.
+type point struct {
+	Generation              int
+	MinFit, MeanFit, MaxFit float64
+	Complexity              float64
+	SpeciesCount            int
+}
+
+// Monitor is an experiments.Observer that keeps an in-memory rolling view of experiment progress and serves it over
+// HTTP. It never blocks the trial loop: generation snapshots are pushed onto a small buffered channel and dropped
+// if a background goroutine can't keep up.
+type Monitor struct {
+	addr string
+
+	frames chan snapshot
+
+	mu      sync.RWMutex
+	history map[int][]point  // trialID -> rolling history
+	best    *genetics.Organism
+}
+
+// snapshot is what gets pushed through the frame queue for background processing.
+type snapshot struct {
+	trialID, generation int
+	pop                 *genetics.Population
+}
+
+// NewMonitor creates a Monitor that will serve its dashboard on addr (e.g. ":6060") once ListenAndServe is called.
+func NewMonitor(addr string) *Monitor {
+	m := &Monitor{
+		addr:    addr,
+		frames:  make(chan snapshot, frameQueueSize),
+		history: make(map[int][]point),
+	}
+	go m.processFrames()
+	return m
+}
+
+// ListenAndServe starts the dashboard's HTTP server. It blocks until the server stops, mirroring net/http.Server's
+// own ListenAndServe convention.
+func (m *Monitor) ListenAndServe() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", m.handleIndex)
+	mux.HandleFunc("/best.svg", m.handleBestSVG)
+	return http.ListenAndServe(m.addr, mux)
+}
+
+// OnGenerationEnd implements experiments.Observer. It is non-blocking: if the frame queue is already full because
+// the processing goroutine has fallen behind, this generation's snapshot is simply dropped in favor of staying out
+// of the trial loop's way.
+func (m *Monitor) OnGenerationEnd(trialID, generation int, pop *genetics.Population) {
+	select {
+	case m.frames <- snapshot{trialID, generation, pop}:
+	default:
+		// UI can't keep up - drop this frame rather than block evolution
+	}
+}
+
+// OnTrialEnd implements experiments.Observer. The dashboard only cares about rolling per-generation history, so
+// trial completion itself requires no additional bookkeeping.
+func (m *Monitor) OnTrialEnd(trialID int, trial experiments.Trial) {}
+
+// OnExperimentEnd implements experiments.Observer. The dashboard only cares about rolling per-generation history,
+// so experiment completion itself requires no additional bookkeeping.
+func (m *Monitor) OnExperimentEnd(experiment *experiments.Experiment) {}
+
+// processFrames drains the frame queue, reducing each population snapshot down to a rolling history point. This
+// runs on its own goroutine so a slow reduction never backs up into OnGenerationEnd.
+func (m *Monitor) processFrames() {
+	for f := range m.frames {
+		p := reducePopulation(f.generation, f.pop)
+
+		m.mu.Lock()
+		h := append(m.history[f.trialID], p)
+		if len(h) > historyLimit {
+			h = h[len(h)-historyLimit:]
+		}
+		m.history[f.trialID] = h
+
+		if best := bestOf(f.pop); best != nil && (m.best == nil || best.Fitness > m.best.Fitness) {
+			m.best = best
+		}
+		m.mu.Unlock()
+	}
+}
+
+// reducePopulation computes the rolling statistics point for one generation's population snapshot.
+func reducePopulation(generation int, pop *genetics.Population) point {
+	p := point{Generation: generation, SpeciesCount: len(pop.Species)}
+	if len(pop.Organisms) == 0 {
+		return p
+	}
+	p.MinFit, p.MaxFit = pop.Organisms[0].Fitness, pop.Organisms[0].Fitness
+	sumFit, sumComplexity := 0.0, 0.0
+	for _, org := range pop.Organisms {
+		if org.Fitness < p.MinFit {
+			p.MinFit = org.Fitness
+		}
+		if org.Fitness > p.MaxFit {
+			p.MaxFit = org.Fitness
+		}
+		sumFit += org.Fitness
+		sumComplexity += float64(org.Phenotype.Complexity())
+	}
+	n := float64(len(pop.Organisms))
+	p.MeanFit = sumFit / n
+	p.Complexity = sumComplexity / n
+	return p
+}
+
+// bestOf returns the fittest organism in pop, or nil if pop has no organisms.
+func bestOf(pop *genetics.Population) *genetics.Organism {
+	var best *genetics.Organism
+	for _, org := range pop.Organisms {
+		if best == nil || org.Fitness > best.Fitness {
+			best = org
+		}
+	}
+	return best
+}
+
+// handleIndex renders each trial's rolling min/mean/max fitness chart as inline SVG; complexity and species count
+// are not charted.
+func (m *Monitor) handleIndex(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	fmt.Fprint(w, "<html><head><title>goNEAT experiment monitor</title></head><body>")
+	fmt.Fprint(w, "<h1>goNEAT experiment monitor</h1>")
+	for trialID, history := range m.history {
+		fmt.Fprintf(w, "<h2>Trial %d</h2>", trialID)
+		fmt.Fprint(w, renderHistorySVG(history))
+	}
+	fmt.Fprint(w, `<h2>Current best</h2><img src="/best.svg"/>`)
+	fmt.Fprint(w, "</body></html>")
+}
+
+// handleBestSVG renders the current best organism's network topology as a simple node/link SVG diagram.
+func (m *Monitor) handleBestSVG(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	best := m.best
+	m.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if best == nil {
+		fmt.Fprint(w, `<svg xmlns="http://www.w3.org/2000/svg" width="200" height="40">`+
+			`<text x="10" y="20">no winner yet</text></svg>`)
+		return
+	}
+	fmt.Fprint(w, renderNetworkSVG(best))
+}
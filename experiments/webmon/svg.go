@@ -0,0 +1,119 @@
+package webmon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dmarkham/goNEAT/neat/genetics"
+	"github.com/dmarkham/goNEAT/neat/network"
+)
+
+const (
+	chartWidth  = 600
+	chartHeight = 150
+	chartMargin = 20
+)
+
+// renderHistorySVG draws min/mean/max fitness as three polylines over the given rolling history.
+func renderHistorySVG(history []point) string {
+	if len(history) == 0 {
+		return `<svg xmlns="http://www.w3.org/2000/svg" width="600" height="150"></svg>`
+	}
+
+	minFit, maxFit := history[0].MinFit, history[0].MaxFit
+	for _, p := range history {
+		if p.MinFit < minFit {
+			minFit = p.MinFit
+		}
+		if p.MaxFit > maxFit {
+			maxFit = p.MaxFit
+		}
+	}
+	if maxFit == minFit {
+		maxFit = minFit + 1
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d">`, chartWidth, chartHeight)
+	b.WriteString(polyline(history, minFit, maxFit, func(p point) float64 { return p.MinFit }, "blue"))
+	b.WriteString(polyline(history, minFit, maxFit, func(p point) float64 { return p.MeanFit }, "black"))
+	b.WriteString(polyline(history, minFit, maxFit, func(p point) float64 { return p.MaxFit }, "red"))
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// polyline plots one series (selected via pick) from history as an SVG polyline scaled into the chart area.
+func polyline(history []point, minVal, maxVal float64, pick func(point) float64, color string) string {
+	plotWidth := float64(chartWidth - 2*chartMargin)
+	plotHeight := float64(chartHeight - 2*chartMargin)
+
+	var coords strings.Builder
+	for i, p := range history {
+		x := chartMargin + plotWidth*float64(i)/float64(max(len(history)-1, 1))
+		y := chartMargin + plotHeight*(1-(pick(p)-minVal)/(maxVal-minVal))
+		if i > 0 {
+			coords.WriteString(" ")
+		}
+		fmt.Fprintf(&coords, "%.1f,%.1f", x, y)
+	}
+	return fmt.Sprintf(`<polyline fill="none" stroke="%s" points="%s"/>`, color, coords.String())
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// renderNetworkSVG draws a simple layered node/link diagram of an organism's phenotype network, ordering nodes by
+// neuron type (input, hidden, output) into columns and drawing a line per link.
+func renderNetworkSVG(org *genetics.Organism) string {
+	net := org.Phenotype
+	nodes := net.AllNodes()
+
+	const colWidth, rowHeight, margin = 200, 40, 20
+	pos := make(map[int][2]float64, len(nodes))
+	seen := map[int]int{0: 0, 1: 0, 2: 0}
+	height := margin * 2
+	for _, n := range nodes {
+		col := neuronColumn(int(n.NeuronType))
+		row := seen[col]
+		seen[col]++
+		x := margin + col*colWidth
+		y := margin + row*rowHeight
+		pos[n.Id] = [2]float64{float64(x), float64(y)}
+		if y+rowHeight > height {
+			height = y + rowHeight
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d">`, margin*2+colWidth*3, height)
+	for _, n := range nodes {
+		for _, link := range n.Incoming {
+			from, to := pos[link.InNode.Id], pos[link.OutNode.Id]
+			fmt.Fprintf(&b, `<line x1="%.0f" y1="%.0f" x2="%.0f" y2="%.0f" stroke="gray"/>`,
+				from[0], from[1], to[0], to[1])
+		}
+	}
+	for _, n := range nodes {
+		p := pos[n.Id]
+		fmt.Fprintf(&b, `<circle cx="%.0f" cy="%.0f" r="6" fill="black"/>`, p[0], p[1])
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// neuronColumn maps a neuron type to the column it is drawn in: inputs and bias on the left, outputs on the right,
+// and everything else (hidden) in the middle.
+func neuronColumn(neuronType int) int {
+	switch neuronType {
+	case int(network.InputNeuron), int(network.BiasNeuron):
+		return 0
+	case int(network.OutputNeuron):
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,45 @@
+package genetics
+
+// BehaviorProvider is implemented by organisms that can report a behavior descriptor for use by novelty search
+// (see experiments.NoveltySearch). ApplyNoveltyScores checks each organism for this interface and, when present,
+// feeds its descriptor into the population's novelty evaluation.
+type BehaviorProvider interface {
+	// BehaviorDescriptor returns the point in behavior space this organism occupies
+	BehaviorDescriptor() []float64
+}
+
+// NoveltyEvaluator is the subset of experiments.NoveltySearch that ApplyNoveltyScores needs. It's declared here,
+// rather than importing the experiments package directly, to avoid a genetics -> experiments import cycle
+// (experiments already imports genetics).
+type NoveltyEvaluator interface {
+	// Evaluate scores descriptor's novelty against population and the evaluator's own archive, returning the score
+	Evaluate(descriptor []float64, population [][]float64) float64
+}
+
+// ApplyNoveltyScores is the hook a reproduction loop should call once per epoch, after every organism's raw fitness
+// has been assigned, so that organisms implementing BehaviorProvider also get scored for novelty via evaluator. The
+// returned map holds each scored organism's novelty, keyed by its position in organisms, for the caller to blend
+// into fitness (e.g. via experiments.NoveltySearch.EffectiveFitness); organisms' Fitness values are not changed
+// here. Organisms that don't implement BehaviorProvider are skipped entirely and absent from the result.
+//
+// Nothing in this package calls ApplyNoveltyScores; a reproduction loop that wants novelty scores calls it itself.
+func ApplyNoveltyScores(organisms []*Organism, evaluator NoveltyEvaluator) map[int]float64 {
+	descriptors := make(map[int][]float64, len(organisms))
+	for i, org := range organisms {
+		if provider, ok := interface{}(org).(BehaviorProvider); ok {
+			descriptors[i] = provider.BehaviorDescriptor()
+		}
+	}
+
+	novelty := make(map[int]float64, len(descriptors))
+	for i, descriptor := range descriptors {
+		population := make([][]float64, 0, len(descriptors)-1)
+		for j, other := range descriptors {
+			if j != i {
+				population = append(population, other)
+			}
+		}
+		novelty[i] = evaluator.Evaluate(descriptor, population)
+	}
+	return novelty
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/dmarkham/goNEAT/neat/utils"
 )
 
+// ONNXGenomeEncoding identifies the ONNX model protobuf genome encoding, alongside PlainGenomeEncoding and
+// YAMLGenomeEncoding
+const ONNXGenomeEncoding GenomeEncoding = 2
+
 // The interface to define genome writer
 type GenomeWriter interface {
 	// Writes Genome record
@@ -24,6 +28,8 @@ func NewGenomeWriter(w io.Writer, encoding GenomeEncoding) (GenomeWriter, error)
 		return &plainGenomeWriter{w:bufio.NewWriter(w)}, nil
 	case YAMLGenomeEncoding:
 		return &yamlGenomeWriter{w:bufio.NewWriter(w)}, nil
+	case ONNXGenomeEncoding:
+		return &onnxGenomeWriter{w: w}, nil
 	default:
 		return nil, ErrUnsupportedGenomeEncoding
 	}
@@ -0,0 +1,81 @@
+package genetics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// protoBuf is a minimal protobuf wire-format encoder covering just the field types ONNX's protobuf messages need
+// (varint, length-delimited, and fixed32 float). It exists so the ONNX genome writer has no dependency on a
+// generated protobuf package.
+type protoBuf struct {
+	buf bytes.Buffer
+}
+
+// wireType identifies a protobuf wire format as used in a field tag.
+type wireType int
+
+const (
+	wireVarint  wireType = 0
+	wireFixed32 wireType = 5
+	wireBytes   wireType = 2
+)
+
+func (p *protoBuf) tag(fieldNum int, wt wireType) {
+	p.varint(uint64(fieldNum)<<3 | uint64(wt))
+}
+
+func (p *protoBuf) varint(v uint64) {
+	for v >= 0x80 {
+		p.buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	p.buf.WriteByte(byte(v))
+}
+
+// Int64Field writes a varint-encoded int64 field.
+func (p *protoBuf) Int64Field(fieldNum int, v int64) {
+	p.tag(fieldNum, wireVarint)
+	p.varint(uint64(v))
+}
+
+// StringField writes a length-delimited UTF-8 string field.
+func (p *protoBuf) StringField(fieldNum int, v string) {
+	p.BytesField(fieldNum, []byte(v))
+}
+
+// BytesField writes a length-delimited bytes field, used both for raw bytes and for embedded sub-messages.
+func (p *protoBuf) BytesField(fieldNum int, v []byte) {
+	p.tag(fieldNum, wireBytes)
+	p.varint(uint64(len(v)))
+	p.buf.Write(v)
+}
+
+// MessageField writes an embedded message, given its already-encoded bytes.
+func (p *protoBuf) MessageField(fieldNum int, msg *protoBuf) {
+	p.BytesField(fieldNum, msg.Bytes())
+}
+
+// FloatField writes a fixed32 (float32) field.
+func (p *protoBuf) FloatField(fieldNum int, v float32) {
+	p.tag(fieldNum, wireFixed32)
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+	p.buf.Write(b[:])
+}
+
+// PackedFloats writes a packed repeated float field, as used by floatInitializer for TensorProto.float_data.
+func (p *protoBuf) PackedFloats(fieldNum int, values []float32) {
+	var raw bytes.Buffer
+	for _, v := range values {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+		raw.Write(b[:])
+	}
+	p.BytesField(fieldNum, raw.Bytes())
+}
+
+func (p *protoBuf) Bytes() []byte {
+	return p.buf.Bytes()
+}
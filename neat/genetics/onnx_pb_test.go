@@ -0,0 +1,162 @@
+package genetics
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// decodedField is one (field number, wire type, payload) triple read back off a protoBuf's encoded bytes, used to
+// assert the encoder emits well-formed protobuf wire format without depending on a generated protobuf package.
+type decodedField struct {
+	fieldNum int
+	wt       wireType
+	varint   uint64
+	bytes    []byte
+}
+
+// decodeFields parses buf as a flat sequence of protobuf wire-format fields, mirroring the subset of the format that
+// protoBuf.tag/varint/BytesField/FloatField produce.
+func decodeFields(t *testing.T, buf []byte) []decodedField {
+	t.Helper()
+	var fields []decodedField
+	i := 0
+	readVarint := func() uint64 {
+		var v uint64
+		var shift uint
+		for {
+			b := buf[i]
+			i++
+			v |= uint64(b&0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+			shift += 7
+		}
+		return v
+	}
+
+	for i < len(buf) {
+		tag := readVarint()
+		fieldNum := int(tag >> 3)
+		wt := wireType(tag & 0x7)
+		switch wt {
+		case wireVarint:
+			fields = append(fields, decodedField{fieldNum: fieldNum, wt: wt, varint: readVarint()})
+		case wireFixed32:
+			b := buf[i : i+4]
+			i += 4
+			fields = append(fields, decodedField{fieldNum: fieldNum, wt: wt, bytes: b})
+		case wireBytes:
+			n := int(readVarint())
+			b := buf[i : i+n]
+			i += n
+			fields = append(fields, decodedField{fieldNum: fieldNum, wt: wt, bytes: b})
+		default:
+			t.Fatalf("unsupported wire type %d in encoded output", wt)
+		}
+	}
+	return fields
+}
+
+func TestProtoBufInt64FieldRoundTrips(t *testing.T) {
+	p := &protoBuf{}
+	p.Int64Field(3, 150)
+
+	fields := decodeFields(t, p.Bytes())
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+	if fields[0].fieldNum != 3 || fields[0].wt != wireVarint {
+		t.Fatalf("unexpected field header: %+v", fields[0])
+	}
+	if fields[0].varint != 150 {
+		t.Errorf("decoded varint = %d, want 150", fields[0].varint)
+	}
+}
+
+func TestProtoBufStringFieldRoundTrips(t *testing.T) {
+	p := &protoBuf{}
+	p.StringField(2, "goNEAT")
+
+	fields := decodeFields(t, p.Bytes())
+	if len(fields) != 1 || fields[0].fieldNum != 2 || fields[0].wt != wireBytes {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+	if got := string(fields[0].bytes); got != "goNEAT" {
+		t.Errorf("decoded string = %q, want %q", got, "goNEAT")
+	}
+}
+
+func TestProtoBufFloatFieldRoundTrips(t *testing.T) {
+	p := &protoBuf{}
+	p.FloatField(5, 3.5)
+
+	fields := decodeFields(t, p.Bytes())
+	if len(fields) != 1 || fields[0].fieldNum != 5 || fields[0].wt != wireFixed32 {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+	got := math.Float32frombits(binary.LittleEndian.Uint32(fields[0].bytes))
+	if got != 3.5 {
+		t.Errorf("decoded float = %v, want 3.5", got)
+	}
+}
+
+func TestProtoBufPackedFloatsRoundTrips(t *testing.T) {
+	p := &protoBuf{}
+	values := []float32{1, -2.5, 1e10}
+	p.PackedFloats(4, values)
+
+	fields := decodeFields(t, p.Bytes())
+	if len(fields) != 1 || fields[0].fieldNum != 4 || fields[0].wt != wireBytes {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+	raw := fields[0].bytes
+	if len(raw) != 4*len(values) {
+		t.Fatalf("packed payload length = %d, want %d", len(raw), 4*len(values))
+	}
+	for i, want := range values {
+		got := math.Float32frombits(binary.LittleEndian.Uint32(raw[i*4 : i*4+4]))
+		if got != want {
+			t.Errorf("packed float %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestProtoBufMessageFieldNestsSubMessageBytes(t *testing.T) {
+	inner := &protoBuf{}
+	inner.Int64Field(1, 42)
+
+	outer := &protoBuf{}
+	outer.MessageField(8, inner)
+
+	fields := decodeFields(t, outer.Bytes())
+	if len(fields) != 1 || fields[0].fieldNum != 8 || fields[0].wt != wireBytes {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+	innerFields := decodeFields(t, fields[0].bytes)
+	if len(innerFields) != 1 || innerFields[0].fieldNum != 1 || innerFields[0].varint != 42 {
+		t.Errorf("nested message did not decode correctly: %+v", innerFields)
+	}
+}
+
+func TestProtoBufMultipleFieldsRoundTrip(t *testing.T) {
+	p := &protoBuf{}
+	p.Int64Field(1, 7)
+	p.StringField(2, "goNEAT")
+	p.StringField(3, "1")
+
+	fields := decodeFields(t, p.Bytes())
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+	if fields[0].varint != 7 {
+		t.Errorf("field 1 = %d, want 7", fields[0].varint)
+	}
+	if string(fields[1].bytes) != "goNEAT" {
+		t.Errorf("field 2 = %q, want %q", fields[1].bytes, "goNEAT")
+	}
+	if string(fields[2].bytes) != "1" {
+		t.Errorf("field 3 = %q, want %q", fields[2].bytes, "1")
+	}
+}
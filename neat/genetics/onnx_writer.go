@@ -0,0 +1,352 @@
+package genetics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/dmarkham/goNEAT/neat/network"
+	"github.com/dmarkham/goNEAT/neat/utils"
+)
+
+// onnxElemFloat is the ONNX TensorProto.DataType value for FLOAT (32-bit), the only element type this writer emits.
+const onnxElemFloat = 1
+
+// The ONNX model protobuf encoded genome writer. It compiles a genome's phenotype network into an ONNX graph: one
+// node tensor per NEAT network node, Mul/Add ops implementing the weighted sum of each node's incoming links, and
+// an activation op matching the node's NEAT activation type. Recurrent links are unrolled one step: the value on a
+// recurrent link is taken from a graph input rather than from this step's computation, so the caller feeds back the
+// previous step's node values between invocations. MIMO control genes are folded in as an extra node whose output
+// multiplies the activated value of each of the module's output nodes.
+type onnxGenomeWriter struct {
+	w io.Writer
+}
+
+func (wr *onnxGenomeWriter) WriteGenome(g *Genome) error {
+	graph, err := buildONNXGraph(g)
+	if err != nil {
+		return err
+	}
+
+	model := &protoBuf{}
+	model.Int64Field(1, 7) // ir_version
+	model.StringField(2, "goNEAT")
+	model.StringField(3, "1")
+	opset := &protoBuf{}
+	opset.StringField(1, "") // default domain
+	opset.Int64Field(2, 13)
+	model.MessageField(8, opset)
+	model.MessageField(7, graph)
+
+	_, err = wr.w.Write(model.Bytes())
+	return err
+}
+
+// onnxEdge is one non-recurrent, enabled connection gene feeding a node's weighted sum.
+type onnxEdge struct {
+	gene *Gene
+}
+
+// buildONNXGraph topologically sorts g's phenotype network over its non-recurrent links and emits the
+// corresponding ONNX GraphProto.
+func buildONNXGraph(g *Genome) (*protoBuf, error) {
+	incoming := make(map[int][]onnxEdge, len(g.Nodes))
+	recurrent := make(map[int][]onnxEdge, len(g.Nodes))
+
+	for _, gene := range g.Genes {
+		if !gene.IsEnabled {
+			continue
+		}
+		link := gene.Link
+		if link.IsRecurrent {
+			recurrent[link.OutNode.Id] = append(recurrent[link.OutNode.Id], onnxEdge{gene})
+		} else {
+			incoming[link.OutNode.Id] = append(incoming[link.OutNode.Id], onnxEdge{gene})
+		}
+	}
+
+	order, err := topoSortNodes(g.Nodes, incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &protoBuf{}
+	graph.StringField(2, fmt.Sprintf("genome_%d", g.Id))
+
+	// finalTensor holds, for each node, the name of the tensor currently holding its value. It starts out as each
+	// node's own input/computed tensor, then appendControlGene overwrites entries for nodes gated by a control gene,
+	// so the graph's declared outputs always reference the tensor that was actually computed last for that node.
+	finalTensor := make(map[int]string, len(g.Nodes))
+
+	for _, n := range order {
+		if len(incoming[n.Id]) == 0 && len(recurrent[n.Id]) == 0 {
+			// sensor/bias node - becomes a graph input fed by the caller
+			inTensor := fmt.Sprintf("input_%d", n.Id)
+			graph.MessageField(11, valueInfo(inTensor))
+			finalTensor[n.Id] = inTensor
+			continue
+		}
+
+		preTensor := fmt.Sprintf("node_%d_pre", n.Id)
+		terms := make([]string, 0, len(incoming[n.Id])+len(recurrent[n.Id]))
+
+		for _, e := range incoming[n.Id] {
+			src := nodeTensorName(e.gene.Link.InNode, incoming)
+			terms = append(terms, weightedTerm(graph, src, e.gene))
+		}
+		for _, e := range recurrent[n.Id] {
+			src := fmt.Sprintf("recur_%d", e.gene.InnovationNum)
+			graph.MessageField(11, valueInfo(src))
+			terms = append(terms, weightedTerm(graph, src, e.gene))
+		}
+
+		sumAddChain(graph, preTensor, terms)
+
+		outTensor := fmt.Sprintf("node_%d", n.Id)
+		if err := appendActivation(graph, preTensor, outTensor, n.ActivationType); err != nil {
+			return nil, err
+		}
+		finalTensor[n.Id] = outTensor
+	}
+
+	for _, cg := range g.ControlGenes {
+		if err := appendControlGene(graph, cg, incoming, finalTensor); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range g.Nodes {
+		if int(n.NeuronType) == int(network.OutputNeuron) {
+			graph.MessageField(12, valueInfo(finalTensor[n.Id]))
+		}
+	}
+
+	return graph, nil
+}
+
+// topoSortNodes orders g's nodes so that every node appears after all nodes its non-recurrent incoming links
+// depend on (Kahn's algorithm). Recurrent links are ignored, since their value comes from the previous step rather
+// than constraining this step's evaluation order.
+func topoSortNodes(nodes []*network.NNode, incoming map[int][]onnxEdge) ([]*network.NNode, error) {
+	inDegree := make(map[int]int, len(nodes))
+	for _, n := range nodes {
+		inDegree[n.Id] = len(incoming[n.Id])
+	}
+
+	var ready []*network.NNode
+	for _, n := range nodes {
+		if inDegree[n.Id] == 0 {
+			ready = append(ready, n)
+		}
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].Id < ready[j].Id })
+
+	byId := make(map[int]*network.NNode, len(nodes))
+	for _, n := range nodes {
+		byId[n.Id] = n
+	}
+	// list each node's dependents, so we know whose in-degree to decrement once it is emitted
+	dependents := make(map[int][]int)
+	for outId, edges := range incoming {
+		for _, e := range edges {
+			dependents[e.gene.Link.InNode.Id] = append(dependents[e.gene.Link.InNode.Id], outId)
+		}
+	}
+
+	var order []*network.NNode
+	visited := make(map[int]bool, len(nodes))
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		if visited[n.Id] {
+			continue
+		}
+		visited[n.Id] = true
+		order = append(order, n)
+
+		for _, depId := range dependents[n.Id] {
+			inDegree[depId]--
+			if inDegree[depId] == 0 {
+				ready = append(ready, byId[depId])
+			}
+		}
+	}
+
+	if len(order) != len(nodes) {
+		return nil, fmt.Errorf("onnx export: network contains a non-recurrent cycle, cannot topologically sort")
+	}
+	return order, nil
+}
+
+// nodeTensorName returns the ONNX tensor name holding n's current value: either its graph input (sensor/bias nodes)
+// or its computed activation output.
+func nodeTensorName(n *network.NNode, incoming map[int][]onnxEdge) string {
+	if len(incoming[n.Id]) == 0 {
+		return fmt.Sprintf("input_%d", n.Id)
+	}
+	return fmt.Sprintf("node_%d", n.Id)
+}
+
+// weightedTerm emits a Mul node computing src * gene.Link.Weight and returns the resulting tensor name.
+func weightedTerm(graph *protoBuf, src string, gene *Gene) string {
+	return weightedLinkTerm(graph, src, gene.Link.Weight, fmt.Sprintf("term_%d", gene.InnovationNum))
+}
+
+// weightedLinkTerm emits a Mul node computing src * weight and returns the resulting tensor name, named explicitly
+// by the caller. Used directly by control gene module links, which aren't backed by a Gene/InnovationNum the way
+// weightedTerm's callers are.
+func weightedLinkTerm(graph *protoBuf, src string, weight float64, name string) string {
+	weightTensor := name + "_w"
+	graph.MessageField(5, floatInitializer(weightTensor, float32(weight)))
+
+	node := &protoBuf{}
+	node.StringField(1, src)
+	node.StringField(1, weightTensor)
+	node.StringField(2, name)
+	node.StringField(4, "Mul")
+	graph.MessageField(1, node)
+	return name
+}
+
+// sumAddChain emits a chain of Add ops reducing terms down to a single tensor named sum, or an Identity copy when
+// there is only one term.
+func sumAddChain(graph *protoBuf, sum string, terms []string) {
+	if len(terms) == 0 {
+		return
+	}
+	acc := terms[0]
+	for i := 1; i < len(terms); i++ {
+		out := acc
+		if i < len(terms)-1 {
+			out = fmt.Sprintf("%s_acc%d", sum, i)
+		} else {
+			out = sum
+		}
+		node := &protoBuf{}
+		node.StringField(1, acc)
+		node.StringField(1, terms[i])
+		node.StringField(2, out)
+		node.StringField(4, "Add")
+		graph.MessageField(1, node)
+		acc = out
+	}
+	if len(terms) == 1 {
+		node := &protoBuf{}
+		node.StringField(1, acc)
+		node.StringField(2, sum)
+		node.StringField(4, "Identity")
+		graph.MessageField(1, node)
+	}
+}
+
+// appendActivation emits the ONNX op(s) implementing activationType, reading from in and writing to out. Standard
+// sigmoid/tanh/relu activations map to their matching ONNX op; the NEAT steepened sigmoid is expressed as a fused
+// Mul (by the steepening constant) followed by Sigmoid, since ONNX has no native steepened-sigmoid op.
+func appendActivation(graph *protoBuf, in, out string, activationType utils.NodeActivationType) error {
+	name, err := utils.NodeActivators.ActivationNameFromType(activationType)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "SigmoidSteepenedActivation":
+		scaled := in + "_steep"
+		graph.MessageField(5, floatInitializer(out+"_steepness", 4.924273)) // NEAT's standard steepening constant
+		scaleNode := &protoBuf{}
+		scaleNode.StringField(1, in)
+		scaleNode.StringField(1, out+"_steepness")
+		scaleNode.StringField(2, scaled)
+		scaleNode.StringField(4, "Mul")
+		graph.MessageField(1, scaleNode)
+
+		node := &protoBuf{}
+		node.StringField(1, scaled)
+		node.StringField(2, out)
+		node.StringField(4, "Sigmoid")
+		graph.MessageField(1, node)
+	case "SigmoidActivation":
+		appendUnaryOp(graph, in, out, "Sigmoid")
+	case "TanhActivation":
+		appendUnaryOp(graph, in, out, "Tanh")
+	case "ReLUActivation", "LinearActivation":
+		op := "Relu"
+		if name == "LinearActivation" {
+			op = "Identity"
+		}
+		appendUnaryOp(graph, in, out, op)
+	default:
+		// fall back to identity for activation types with no direct ONNX equivalent
+		appendUnaryOp(graph, in, out, "Identity")
+	}
+	return nil
+}
+
+func appendUnaryOp(graph *protoBuf, in, out, opType string) {
+	node := &protoBuf{}
+	node.StringField(1, in)
+	node.StringField(2, out)
+	node.StringField(4, opType)
+	graph.MessageField(1, node)
+}
+
+// appendControlGene folds a MIMO control gene's module into the graph as an extra gating node: its inputs are
+// combined (each weighted by its own link, same as a regular node's incoming links) via the module's own activation
+// function, and the result multiplies the current value (after activation) of each of the module's output nodes. finalTensor is
+// updated so that each gated output node's declared graph output reflects the gated value rather than its
+// pre-gating tensor.
+func appendControlGene(graph *protoBuf, cg *MIMOControlGene, incoming map[int][]onnxEdge, finalTensor map[int]string) error {
+	ctrl := cg.ControlNode
+	terms := make([]string, 0, len(ctrl.Incoming))
+	for i, link := range ctrl.Incoming {
+		src := nodeTensorName(link.InNode, incoming)
+		name := fmt.Sprintf("module_%d_in%d", ctrl.Id, i)
+		terms = append(terms, weightedLinkTerm(graph, src, link.Weight, name))
+	}
+	pre := fmt.Sprintf("module_%d_pre", ctrl.Id)
+	sumAddChain(graph, pre, terms)
+
+	out := fmt.Sprintf("module_%d", ctrl.Id)
+	if err := appendActivation(graph, pre, out, ctrl.ActivationType); err != nil {
+		return err
+	}
+
+	for i, link := range ctrl.Outgoing {
+		gatedOut := fmt.Sprintf("module_%d_out%d", ctrl.Id, i)
+		gated := &protoBuf{}
+		gated.StringField(1, out)
+		gated.StringField(1, finalTensor[link.OutNode.Id])
+		gated.StringField(2, gatedOut)
+		gated.StringField(4, "Mul")
+		graph.MessageField(1, gated)
+		finalTensor[link.OutNode.Id] = gatedOut
+	}
+	return nil
+}
+
+// valueInfo builds an ONNX ValueInfoProto describing a scalar float tensor with the given name.
+func valueInfo(name string) *protoBuf {
+	dim := &protoBuf{}
+	dim.Int64Field(1, 1)
+	shape := &protoBuf{}
+	shape.MessageField(1, dim)
+	tensorType := &protoBuf{}
+	tensorType.Int64Field(1, onnxElemFloat)
+	tensorType.MessageField(2, shape)
+	typ := &protoBuf{}
+	typ.MessageField(1, tensorType)
+
+	vi := &protoBuf{}
+	vi.StringField(1, name)
+	vi.MessageField(2, typ)
+	return vi
+}
+
+// floatInitializer builds an ONNX TensorProto holding a single scalar float32 constant, used for connection weights
+// and activation constants.
+func floatInitializer(name string, value float32) *protoBuf {
+	t := &protoBuf{}
+	t.Int64Field(2, onnxElemFloat)
+	t.PackedFloats(4, []float32{value})
+	t.StringField(8, name)
+	return t
+}